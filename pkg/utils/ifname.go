@@ -0,0 +1,49 @@
+// Copyright 2026 CNI authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+
+	cniutils "github.com/containernetworking/cni/pkg/utils"
+)
+
+// MaxIfNameLength is the longest interface name the kernel accepts
+// (IFNAMSIZ minus the trailing NUL).
+const MaxIfNameLength = 15
+
+// UniqueIfName returns the first name made of prefix followed by a
+// numeric suffix (starting at 0) that is not present in existing.
+// Returns an error if the prefix contains characters not allowed in an
+// interface name, or if no such name fits within MaxIfNameLength.
+func UniqueIfName(existing []string, prefix string) (string, error) {
+	taken := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		taken[name] = true
+	}
+
+	for i := 0; ; i++ {
+		name := fmt.Sprintf("%s%d", prefix, i)
+		if len(name) > MaxIfNameLength {
+			return "", fmt.Errorf("no unique interface name with prefix %q fits within %d characters", prefix, MaxIfNameLength)
+		}
+		if err := cniutils.ValidateInterfaceName(name); err != nil {
+			return "", fmt.Errorf("invalid interface name prefix %q: %v", prefix, err)
+		}
+		if !taken[name] {
+			return name, nil
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 CNI authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UniqueIfName", func() {
+	It("returns the first free suffix", func() {
+		name, err := UniqueIfName(nil, "net")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("net0"))
+
+		name, err = UniqueIfName([]string{"eth0", "net0", "net2"}, "net")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("net1"))
+	})
+
+	It("generates unique names against a growing set", func() {
+		existing := []string{"eth0"}
+		for i := 0; i < 20; i++ {
+			name, err := UniqueIfName(existing, "net")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).NotTo(ContainElement(name))
+			Expect(len(name)).To(BeNumerically("<=", MaxIfNameLength))
+			existing = append(existing, name)
+		}
+		Expect(existing).To(ContainElements("net0", "net10", "net19"))
+	})
+
+	It("uses longer suffixes while they fit", func() {
+		existing := []string{}
+		for i := 0; i < 10; i++ {
+			existing = append(existing, "abcdefghijklm"+string(rune('0'+i)))
+		}
+		name, err := UniqueIfName(existing, "abcdefghijklm")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("abcdefghijklm10"))
+		Expect(name).To(HaveLen(MaxIfNameLength))
+	})
+
+	It("rejects prefixes that are not valid in an interface name", func() {
+		for _, prefix := range []string{"a/b", "a:b", "a b", "a\tb"} {
+			_, err := UniqueIfName(nil, prefix)
+			Expect(err).To(MatchError(HavePrefix(`invalid interface name prefix`)), "prefix %q", prefix)
+		}
+	})
+
+	It("accepts a dot prefix", func() {
+		name, err := UniqueIfName(nil, ".")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal(".0"))
+	})
+
+	It("fails when no name fits within the length limit", func() {
+		_, err := UniqueIfName(nil, "abcdefghijklmno")
+		Expect(err).To(MatchError(ContainSubstring("fits within 15 characters")))
+
+		existing := []string{}
+		for i := 0; i < 10; i++ {
+			existing = append(existing, "abcdefghijklmn"+string(rune('0'+i)))
+		}
+		_, err = UniqueIfName(existing, "abcdefghijklmn")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,37 @@
+// Copyright 2026 CNI authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+)
+
+const (
+	// MinMTU is the smallest MTU an IPv4 link must support (RFC 791).
+	MinMTU = 68
+	// MaxMTU is the largest MTU that fits in an IPv4 total length field.
+	MaxMTU = 65535
+)
+
+// ValidateMTU returns an error if mtu is outside of the IPv4 range
+// [MinMTU, MaxMTU]. It is not a general link MTU bound: IPv6 links
+// additionally require at least 1280, and the kernel may cap the
+// maximum lower for a given device.
+func ValidateMTU(mtu int) error {
+	if mtu < MinMTU || mtu > MaxMTU {
+		return fmt.Errorf("invalid MTU %d, must be between %d and %d", mtu, MinMTU, MaxMTU)
+	}
+	return nil
+}
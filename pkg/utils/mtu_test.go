@@ -0,0 +1,41 @@
+// Copyright 2026 CNI authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateMTU", func() {
+	It("accepts MTUs within bounds", func() {
+		for _, mtu := range []int{MinMTU, 1280, 1500, 9000, MaxMTU} {
+			Expect(ValidateMTU(mtu)).To(Succeed())
+		}
+	})
+
+	It("rejects zero", func() {
+		Expect(ValidateMTU(0)).To(MatchError("invalid MTU 0, must be between 68 and 65535"))
+	})
+
+	It("rejects negative values", func() {
+		Expect(ValidateMTU(-1500)).To(HaveOccurred())
+	})
+
+	It("rejects values out of range", func() {
+		Expect(ValidateMTU(MinMTU - 1)).To(HaveOccurred())
+		Expect(ValidateMTU(MaxMTU + 1)).To(HaveOccurred())
+	})
+})
@@ -31,6 +31,22 @@ func FormatChainName(name string, id string) string {
 	return MustFormatChainNameWithPrefix(name, id, "")
 }
 
+// FormatChainNameScoped generates a chain name like FormatChainName,
+// but also hashes the given namespace so that networks with the same
+// name and id in different namespaces get distinct chains. An empty
+// namespace yields the same name as FormatChainName.
+func FormatChainNameScoped(namespace string, name string, id string) string {
+	if namespace == "" {
+		return FormatChainName(name, id)
+	}
+	// The namespace and name are length-prefixed so no field contents
+	// can be shifted into a neighbouring field. The leading NUL keeps
+	// scoped inputs apart from unscoped name+id ones, since neither
+	// network names nor container IDs may contain it.
+	toHash := fmt.Sprintf("\x00%d:%s%d:%s%s", len(namespace), namespace, len(name), name, id)
+	return MustFormatHashWithPrefix(maxChainLength, chainPrefix, toHash)
+}
+
 // MustFormatChainNameWithPrefix generates a chain name similar
 // to FormatChainName, but adds a custom prefix between
 // chainPrefix and unique identifier. Ensures that the
@@ -40,6 +56,29 @@ func MustFormatChainNameWithPrefix(name string, id string, prefix string) string
 	return MustFormatHashWithPrefix(maxChainLength, chainPrefix+prefix, name+id)
 }
 
+// AssertUniqueChains verifies that the chain names generated by
+// FormatChainName for each name/id pair do not collide. Identical
+// pairs are treated as the same network and are not reported.
+func AssertUniqueChains(names []struct{ Name, ID string }) error {
+	return assertUniqueChains(names, FormatChainName)
+}
+
+func assertUniqueChains(names []struct{ Name, ID string }, format func(name, id string) string) error {
+	seen := make(map[string]struct{ Name, ID string }, len(names))
+	for _, n := range names {
+		chain := format(n.Name, n.ID)
+		if other, ok := seen[chain]; ok {
+			if other == n {
+				continue
+			}
+			return fmt.Errorf("chain %s for name %q id %q collides with name %q id %q",
+				chain, n.Name, n.ID, other.Name, other.ID)
+		}
+		seen[chain] = n
+	}
+	return nil
+}
+
 // FormatComment returns a comment used for easier
 // rule identification within iptables.
 func FormatComment(name string, id string) string {
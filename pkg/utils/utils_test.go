@@ -98,6 +98,70 @@ var _ = Describe("Utils", func() {
 		})
 	})
 
+	Describe("FormatChainNameScoped", func() {
+		It("must be predictable", func() {
+			chain1 := FormatChainNameScoped("tenant-a", "test", "1234")
+			chain2 := FormatChainNameScoped("tenant-a", "test", "1234")
+			Expect(chain1).To(HaveLen(maxChainLength))
+			Expect(chain1).To(HavePrefix(chainPrefix))
+			Expect(chain1).To(Equal(chain2))
+		})
+
+		It("must change when the namespace changes", func() {
+			chain1 := FormatChainNameScoped("tenant-a", "test", "1234")
+			chain2 := FormatChainNameScoped("tenant-b", "test", "1234")
+			Expect(chain1).To(HaveLen(maxChainLength))
+			Expect(chain2).To(HaveLen(maxChainLength))
+			Expect(chain1).NotTo(Equal(chain2))
+			Expect(chain1).NotTo(Equal(FormatChainName("test", "1234")))
+		})
+
+		It("must not be confused by moving characters across fields", func() {
+			chain1 := FormatChainNameScoped("tenant", "atest", "1234")
+			chain2 := FormatChainNameScoped("tenanta", "test", "1234")
+			Expect(chain1).NotTo(Equal(chain2))
+		})
+
+		It("must not be confused by separators inside fields", func() {
+			Expect(FormatChainNameScoped("a/b", "c", "1")).NotTo(Equal(FormatChainNameScoped("a", "b/c", "1")))
+			Expect(FormatChainNameScoped("a:", "1:b", "1")).NotTo(Equal(FormatChainNameScoped("a", ":1:b", "1")))
+			Expect(FormatChainNameScoped("a\x00b", "c", "1")).NotTo(Equal(FormatChainNameScoped("a", "b\x00c", "1")))
+		})
+
+		It("must not collide with unscoped chains", func() {
+			Expect(FormatChainNameScoped("ns", "name", "1234")).NotTo(Equal(FormatChainName("ns/name", "1234")))
+			Expect(FormatChainNameScoped("ns", "name", "1234")).NotTo(Equal(FormatChainName("2:ns4:name", "1234")))
+		})
+
+		It("matches FormatChainName for an empty namespace", func() {
+			chain := FormatChainNameScoped("", "test", "1234")
+			Expect(chain).To(Equal("CNI-2bbe0c48b91a7d1b8a6753a8"))
+		})
+	})
+
+	Describe("AssertUniqueChains", func() {
+		It("accepts a collision-free set", func() {
+			Expect(AssertUniqueChains([]struct{ Name, ID string }{
+				{"net1", "1234"},
+				{"net2", "1234"},
+				{"net1", "5678"},
+				{"net1", "1234"},
+			})).To(Succeed())
+		})
+
+		It("reports a collision", func() {
+			collide := func(name, id string) string {
+				return "CNI-collision"
+			}
+
+			err := assertUniqueChains([]struct{ Name, ID string }{
+				{"net1", "1234"},
+				{"net2", "5678"},
+			}, collide)
+			Expect(err).To(MatchError(`chain CNI-collision for name "net2" id "5678" collides with name "net1" id "1234"`))
+		})
+	})
+
 	Describe("MustFormatHashWithPrefix", func() {
 		It("always returns a string with the given prefix", func() {
 			Expect(MustFormatHashWithPrefix(10, "AAA", "some string")).To(HavePrefix("AAA"))
@@ -114,6 +114,11 @@ func loadNetConf(bytes []byte, envArgs string) (*NetConf, string, error) {
 	if n.Vlan < 0 || n.Vlan > 4094 {
 		return nil, "", fmt.Errorf("invalid VLAN ID %d (must be between 0 and 4094)", n.Vlan)
 	}
+	if n.MTU != 0 {
+		if err := utils.ValidateMTU(n.MTU); err != nil {
+			return nil, "", err
+		}
+	}
 	var err error
 	n.vlans, err = collectVlanTrunk(n.VlanTrunk)
 	if err != nil {
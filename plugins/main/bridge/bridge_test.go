@@ -2506,6 +2506,38 @@ var _ = Describe("bridge Operations", func() {
 			})).To(Succeed())
 		})
 
+		It(fmt.Sprintf("[%s] should fail with an out-of-range MTU", ver), func() {
+			conf := fmt.Sprintf(`{
+			    "cniVersion": "%s",
+			    "name": "testConfig",
+			    "type": "bridge",
+			    "bridge": "%s",
+			    "mtu": 70000,
+			    "ipam": {
+				"type": "host-local",
+				"subnet": "10.1.2.0/24",
+				"dataDir": "%s"
+			    }
+			}`, ver, BRNAME, dataDir)
+
+			args := &skel.CmdArgs{
+				ContainerID: "dummy",
+				Netns:       targetNS.Path(),
+				IfName:      IFNAME,
+				StdinData:   []byte(conf),
+			}
+
+			Expect(originalNS.Do(func(ns.NetNS) error {
+				defer GinkgoRecover()
+
+				_, _, err := testutils.CmdAddWithArgs(args, func() error {
+					return cmdAdd(args)
+				})
+				Expect(err).To(MatchError("invalid MTU 70000, must be between 68 and 65535"))
+				return nil
+			})).To(Succeed())
+		})
+
 		It(fmt.Sprintf("[%s] should set the container veth peer state down", ver), func() {
 			Expect(originalNS.Do(func(ns.NetNS) error {
 				defer GinkgoRecover()
@@ -29,6 +29,7 @@ import (
 	"github.com/containernetworking/plugins/pkg/ip"
 	"github.com/containernetworking/plugins/pkg/ipam"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/utils"
 	bv "github.com/containernetworking/plugins/pkg/utils/buildversion"
 	"github.com/containernetworking/plugins/pkg/utils/sysctl"
 )
@@ -54,6 +55,12 @@ func loadConf(args *skel.CmdArgs, cmdCheck bool) (*NetConf, string, error) {
 		return nil, "", fmt.Errorf("failed to load netconf: %v", err)
 	}
 
+	if n.MTU != 0 {
+		if err := utils.ValidateMTU(n.MTU); err != nil {
+			return nil, "", err
+		}
+	}
+
 	if cmdCheck {
 		return n, n.CNIVersion, nil
 	}
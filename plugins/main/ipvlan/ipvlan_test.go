@@ -414,6 +414,40 @@ var _ = Describe("ipvlan Operations", func() {
 				})
 			}
 
+			It(fmt.Sprintf("[%s] fails to create an ipvlan link with out-of-range MTU", ver), func() {
+				conf := fmt.Sprintf(`{
+			    "cniVersion": "%s",
+			    "name": "mynet",
+			    "type": "ipvlan",
+			    "master": "%s",
+			    "mtu": 70000,
+				"linkInContainer": %t,
+			    "ipam": {
+				"type": "host-local",
+				"subnet": "10.1.2.0/24",
+				"dataDir": "%s"
+			    }
+			}`, ver, masterInterface, isInContainer, dataDir)
+
+				args := &skel.CmdArgs{
+					ContainerID: "dummy",
+					Netns:       targetNS.Path(),
+					IfName:      "ipvl0",
+					StdinData:   []byte(conf),
+				}
+
+				err := originalNS.Do(func(ns.NetNS) error {
+					defer GinkgoRecover()
+
+					_, _, err := testutils.CmdAddWithArgs(args, func() error {
+						return cmdAdd(args)
+					})
+					Expect(err).To(MatchError("invalid MTU 70000, must be between 68 and 65535"))
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It(fmt.Sprintf("[%s] deconfigures an unconfigured ipvlan link with DEL", ver), func() {
 				conf := fmt.Sprintf(`{
 			    "cniVersion": "%s",
@@ -376,6 +376,40 @@ var _ = Describe("macvlan Operations", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It(fmt.Sprintf("[%s] fails to create a macvlan link with MTU below the IPv4 minimum", ver), func() {
+				conf := fmt.Sprintf(`{
+			    "cniVersion": "%s",
+			    "name": "mynet",
+			    "type": "macvlan",
+			    "master": "%s",
+			    "mtu": 50,
+			    %s
+			    "ipam": {
+				"type": "host-local",
+				"subnet": "10.1.2.0/24",
+				"dataDir": "%s"
+			    }
+			}`, ver, masterInterface, linkInContainer, dataDir)
+
+				args := &skel.CmdArgs{
+					ContainerID: "dummy",
+					Netns:       targetNS.Path(),
+					IfName:      "macvl0",
+					StdinData:   []byte(conf),
+				}
+
+				err := originalNS.Do(func(ns.NetNS) error {
+					defer GinkgoRecover()
+
+					_, _, err := testutils.CmdAddWithArgs(args, func() error {
+						return cmdAdd(args)
+					})
+					Expect(err).To(MatchError("invalid MTU 50, must be between 68 and 65535"))
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It(fmt.Sprintf("[%s] deconfigures an unconfigured macvlan link with DEL", ver), func() {
 				const IFNAME = "macvl0"
 
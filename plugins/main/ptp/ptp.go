@@ -186,6 +186,12 @@ func cmdAdd(args *skel.CmdArgs) error {
 		return fmt.Errorf("failed to load netconf: %v", err)
 	}
 
+	if conf.MTU != 0 {
+		if err := utils.ValidateMTU(conf.MTU); err != nil {
+			return err
+		}
+	}
+
 	// run the IPAM plugin and get back the config to apply
 	r, err := ipam.ExecAdd(conf.IPAM.Type, args.StdinData)
 	if err != nil {
@@ -313,6 +319,12 @@ func cmdCheck(args *skel.CmdArgs) error {
 		return fmt.Errorf("failed to load netconf: %v", err)
 	}
 
+	if conf.MTU != 0 {
+		if err := utils.ValidateMTU(conf.MTU); err != nil {
+			return err
+		}
+	}
+
 	netns, err := ns.GetNS(args.Netns)
 	if err != nil {
 		return fmt.Errorf("failed to open netns %q: %v", args.Netns, err)
@@ -501,6 +501,43 @@ var _ = Describe("ptp Operations", func() {
 			doTest(conf, ver, 1, types.DNS{}, targetNS)
 		})
 
+		It(fmt.Sprintf("[%s] rejects an out-of-range MTU on ADD and CHECK", ver), func() {
+			conf := fmt.Sprintf(`{
+			    "cniVersion": "%s",
+			    "name": "mynet",
+			    "type": "ptp",
+			    "mtu": 50,
+			    "ipam": {
+				"type": "host-local",
+				"dataDir": "%s",
+				"subnet": "10.1.2.0/24"
+			    }
+			}`, ver, dataDir)
+
+			args := &skel.CmdArgs{
+				ContainerID: "dummy",
+				Netns:       targetNS.Path(),
+				IfName:      "ptp0",
+				StdinData:   []byte(conf),
+			}
+
+			err := originalNS.Do(func(ns.NetNS) error {
+				defer GinkgoRecover()
+
+				_, _, err := testutils.CmdAddWithArgs(args, func() error {
+					return cmdAdd(args)
+				})
+				Expect(err).To(MatchError("invalid MTU 50, must be between 68 and 65535"))
+
+				err = testutils.CmdCheckWithArgs(args, func() error {
+					return cmdCheck(args)
+				})
+				Expect(err).To(MatchError("invalid MTU 50, must be between 68 and 65535"))
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It(fmt.Sprintf("[%s] deconfigures an unconfigured ptp link with DEL", ver), func() {
 			const IFNAME = "ptp0"
 
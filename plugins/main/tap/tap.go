@@ -35,6 +35,7 @@ import (
 	"github.com/containernetworking/plugins/pkg/ip"
 	"github.com/containernetworking/plugins/pkg/ipam"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/utils"
 	bv "github.com/containernetworking/plugins/pkg/utils/buildversion"
 	"github.com/containernetworking/plugins/pkg/utils/sysctl"
 )
@@ -72,6 +73,11 @@ func loadConf(args *skel.CmdArgs) (*NetConf, string, error) {
 	if err := json.Unmarshal(args.StdinData, n); err != nil {
 		return nil, "", fmt.Errorf("failed to load netconf: %v", err)
 	}
+	if n.MTU != 0 {
+		if err := utils.ValidateMTU(n.MTU); err != nil {
+			return nil, "", err
+		}
+	}
 	if args.Args != "" {
 		e := MacEnvArgs{}
 		err := types.LoadArgs(args.Args, &e)
@@ -29,6 +29,7 @@ import (
 	"github.com/containernetworking/plugins/pkg/ip"
 	"github.com/containernetworking/plugins/pkg/ipam"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/utils"
 	bv "github.com/containernetworking/plugins/pkg/utils/buildversion"
 )
 
@@ -67,6 +68,11 @@ func loadConf(args *skel.CmdArgs) (*NetConf, string, error) {
 	if n.MTU < 0 || n.MTU > masterMTU {
 		return nil, "", fmt.Errorf("invalid MTU %d, must be [0, master MTU(%d)]", n.MTU, masterMTU)
 	}
+	if n.MTU != 0 {
+		if err := utils.ValidateMTU(n.MTU); err != nil {
+			return nil, "", err
+		}
+	}
 	return n, n.CNIVersion, nil
 }
 
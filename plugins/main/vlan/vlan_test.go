@@ -530,6 +530,27 @@ var _ = Describe("vlan Operations", func() {
 						return nil
 					})
 				})
+
+				It(fmt.Sprintf("[%s] fails to create vlan link with MTU below the IPv4 minimum", ver), func() {
+					var err error
+
+					args := &skel.CmdArgs{
+						ContainerID: "dummy",
+						Netns:       targetNS.Path(),
+						IfName:      "ethX",
+						StdinData:   []byte(fmt.Sprintf(confFmt, ver, masterInterface, 50, isInContainer, dataDir)),
+					}
+
+					_ = originalNS.Do(func(_ ns.NetNS) error {
+						defer GinkgoRecover()
+
+						_, _, err = testutils.CmdAddWithArgs(args, func() error {
+							return cmdAdd(args)
+						})
+						Expect(err).To(MatchError("invalid MTU 50, must be between 68 and 65535"))
+						return nil
+					})
+				})
 			})
 		}
 	}
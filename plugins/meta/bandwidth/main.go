@@ -32,10 +32,7 @@ import (
 	bv "github.com/containernetworking/plugins/pkg/utils/buildversion"
 )
 
-const (
-	maxIfbDeviceLength = 15
-	ifbDevicePrefix    = "bwp"
-)
+const ifbDevicePrefix = "bwp"
 
 // BandwidthEntry corresponds to a single entry in the bandwidth argument,
 // see CONVENTIONS.md
@@ -129,7 +126,7 @@ func validateRateAndBurst(rate, burst uint64) error {
 }
 
 func getIfbDeviceName(networkName string, containerID string) string {
-	return utils.MustFormatHashWithPrefix(maxIfbDeviceLength, ifbDevicePrefix, networkName+containerID)
+	return utils.MustFormatHashWithPrefix(utils.MaxIfNameLength, ifbDevicePrefix, networkName+containerID)
 }
 
 func getMTUAndQLen(deviceName string) (int, int, error) {
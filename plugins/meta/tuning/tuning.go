@@ -36,6 +36,7 @@ import (
 	current "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/cni/pkg/version"
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/utils"
 	bv "github.com/containernetworking/plugins/pkg/utils/buildversion"
 )
 
@@ -145,6 +146,12 @@ func parseConf(data []byte, envArgs string) (*TuningConf, error) {
 		}
 	}
 
+	if conf.Mtu != 0 {
+		if err := utils.ValidateMTU(conf.Mtu); err != nil {
+			return nil, err
+		}
+	}
+
 	return &conf, nil
 }
 
@@ -489,6 +489,55 @@ var _ = Describe("tuning plugin", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It(fmt.Sprintf("[%s] rejects an out-of-range mtu from config or args", ver), func() {
+			const confFmt = `{
+				"name": "test",
+				"type": "iplink",
+				"cniVersion": "%s",
+				%s
+				"prevResult": {
+					"interfaces": [
+						{"name": "dummy0", "sandbox":"netns"}
+					],
+					"ips": [
+						{
+							"version": "4",
+							"address": "10.0.0.2/24",
+							"gateway": "10.0.0.1",
+							"interface": 0
+						}
+					]
+				}
+			}`
+
+			for _, tc := range []struct {
+				field string
+				err   string
+			}{
+				{`"mtu": 50,`, "invalid MTU 50, must be between 68 and 65535"},
+				{`"args": {"cni": {"mtu": 70000}},`, "invalid MTU 70000, must be between 68 and 65535"},
+				{`"mtu": 1454, "args": {"cni": {"mtu": -1}},`, "invalid MTU -1, must be between 68 and 65535"},
+			} {
+				args := &skel.CmdArgs{
+					ContainerID: "dummy",
+					Netns:       originalNS.Path(),
+					IfName:      IFNAME,
+					StdinData:   []byte(fmt.Sprintf(confFmt, ver, tc.field)),
+				}
+
+				err := originalNS.Do(func(ns.NetNS) error {
+					defer GinkgoRecover()
+
+					_, _, err := testutils.CmdAddWithArgs(args, func() error {
+						return cmdAdd(args)
+					})
+					Expect(err).To(MatchError(tc.err))
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
 		It(fmt.Sprintf("[%s] configures and deconfigures tx queue len with ADD/DEL", ver), func() {
 			conf := []byte(fmt.Sprintf(`{
 				"name": "test",